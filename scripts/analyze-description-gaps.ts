@@ -7,28 +7,51 @@
  *
  * Detection criteria:
  * - Generic descriptions matching "F5 Distributed Cloud {Domain} API specifications"
- * - Missing 3-tier differentiation (description_short = description)
+ * - Missing 3-tier differentiation (x-f5xc-description-short = description)
  * - Auto-generated summaries matching "{Action} {Resource}."
  * - Missing x-f5xc-operation-metadata
+ * - Leading/trailing whitespace in titles and descriptions
+ * - Titles that only repeat the domain name
+ * - Index entries with an empty or whitespace-only domain name
+ * - Titles not in TITLE_CASE_STYLE (Title Case, sentence case, or off)
+ * - Titles shared by more than one domain
+ * - Domains listed more than once with conflicting path/schema counts
+ * - Titles shorter than MIN_TITLE_LENGTH characters (disabled when 0)
+ * - Malformed index entries (reported by position, analysis continues)
+ * - Missing or whitespace-only titles and descriptions
+ * - Titles and descriptions not in Unicode NFC form
+ * - Domains missing x-f5xc-category (when REQUIRE_CATEGORY_COVERAGE is set)
+ * - Long descriptions shared verbatim by more than MAX_DESCRIPTION_REUSE
+ *   domains (disabled when 0)
  *
- * Output: Markdown report for creating GitHub issues in upstream repo
+ * Title hygiene findings (whitespace, casing, length, shared titles, NFC) and
+ * reused descriptions are reported as info and do not contribute to a domain's severity.
+ *
+ * Output: Markdown report for creating GitHub issues in upstream repo,
+ * including domain coverage grouped by x-f5xc-category
  *
  * Run: npx tsx scripts/analyze-description-gaps.ts
  */
 
 import * as fs from "fs";
 import * as path from "path";
+import { fileURLToPath } from "url";
+import { loadSpecIndex } from "./spec-index.js";
 
 // Types
-interface SpecIndexEntry {
+export interface SpecIndexEntry {
 	domain: string;
 	title: string;
 	description: string;
-	description_short: string;
-	description_medium: string;
+	// x-f5xc-* extension fields; either tier may be absent upstream
+	"x-f5xc-description-short"?: string;
+	"x-f5xc-description-medium"?: string;
+	path_count: number;
+	schema_count: number;
+	"x-f5xc-category"?: string;
 }
 
-interface SpecIndex {
+export interface SpecIndex {
 	version: string;
 	specifications: SpecIndexEntry[];
 }
@@ -58,10 +81,20 @@ interface OpenAPISpec {
 	paths: Record<string, OpenAPIPathItem>;
 }
 
-interface DomainGap {
+export interface DomainGap {
 	domain: string;
 	issues: string[];
-	severity: "high" | "medium" | "low";
+	// Informational findings; listed in the report but never raise severity
+	info: string[];
+	severity: "high" | "medium" | "low" | "info";
+}
+
+interface CategoryCoverage {
+	category: string;
+	domains: number;
+	domainsWithGaps: number;
+	issueCount: number;
+	coverage: number;
 }
 
 interface OperationGap {
@@ -72,6 +105,12 @@ interface OperationGap {
 	issues: string[];
 }
 
+// Bucket for index entries without x-f5xc-category
+const UNCATEGORIZED = "(uncategorized)";
+
+// Report each domain lacking x-f5xc-category (the count is always summarized)
+const REQUIRE_CATEGORY_COVERAGE = false;
+
 // Generic description patterns to detect
 const GENERIC_PATTERNS = [
 	/^F5 Distributed Cloud .* API specifications?\.?$/i,
@@ -80,6 +119,56 @@ const GENERIC_PATTERNS = [
 	/^The .* API\.?$/i,
 ];
 
+// Titles shorter than this (in characters) are too terse to be useful
+// (0 disables the check)
+const MIN_TITLE_LENGTH = 0;
+
+// Descriptions shared verbatim by more domains than this are boilerplate
+// (0 disables the check)
+const MAX_DESCRIPTION_REUSE = 0;
+
+// Capitalization convention titles are checked against
+type TitleCaseStyle = "title" | "sentence" | "none";
+const TITLE_CASE_STYLE: TitleCaseStyle = "title";
+
+// Words left lower case inside a Title Case title
+const TITLE_MINOR_WORDS = new Set([
+	"a",
+	"an",
+	"and",
+	"as",
+	"at",
+	"by",
+	"for",
+	"in",
+	"of",
+	"on",
+	"or",
+	"the",
+	"to",
+	"via",
+	"with",
+]);
+
+// Acronyms allowed to stay upper case in titles
+const TITLE_ACRONYMS = new Set([
+	"AI",
+	"API",
+	"BGP",
+	"CDN",
+	"DDOS",
+	"DNS",
+	"F5",
+	"IP",
+	"K8S",
+	"SSL",
+	"TLS",
+	"VPN",
+	"WAAP",
+	"WAF",
+	"XC",
+]);
+
 // Auto-generated summary patterns
 const AUTO_SUMMARY_PATTERNS = [
 	/^(Create|Get|List|Delete|Replace|Update) [A-Z][a-z]+\.?$/,
@@ -90,10 +179,11 @@ const AUTO_SUMMARY_PATTERNS = [
  * Check if a description is generic/placeholder
  */
 function isGenericDescription(desc: string): boolean {
-	if (!desc || desc.length < 20) return true;
+	const trimmed = desc?.trim();
+	if (!trimmed || trimmed.length < 20) return true;
 
 	for (const pattern of GENERIC_PATTERNS) {
-		if (pattern.test(desc)) return true;
+		if (pattern.test(trimmed)) return true;
 	}
 
 	return false;
@@ -112,36 +202,327 @@ function isAutoGeneratedSummary(summary: string): boolean {
 	return false;
 }
 
+/**
+ * Check if a value is non-empty but contains only whitespace
+ */
+function isWhitespaceOnly(value: string): boolean {
+	return !!value && value.trim() === "";
+}
+
+/**
+ * Check if a value differs from its Unicode NFC form
+ */
+function isNotNfc(value: string): boolean {
+	return !!value && value !== value.normalize("NFC");
+}
+
+/**
+ * Check if a value has leading or trailing whitespace around real content
+ */
+function isUntrimmed(value: string): boolean {
+	return !!value && !isWhitespaceOnly(value) && value !== value.trim();
+}
+
+/**
+ * Check if a title just repeats the domain identifier (e.g. "dns", "load balancer")
+ */
+function isTitleDomainName(title: string, domain: string): boolean {
+	const normalized = title.trim().toLowerCase();
+	return (
+		normalized === domain.toLowerCase() ||
+		normalized === domain.toLowerCase().replace(/_/g, " ")
+	);
+}
+
+/**
+ * Check if a title doesn't follow the given capitalization style
+ */
+function hasInconsistentTitleCase(title: string, style: TitleCaseStyle): boolean {
+	if (style === "none") return false;
+
+	// Split on punctuation too, so "DNS (WAF)" and "API/WAF" yield bare words
+	const words = title.split(/[^\p{L}\p{N}]+/u).filter((w) => /\p{L}/u.test(w));
+	if (words.length === 0) return false;
+
+	// Single letters read the same in any case, so they can't be ALL CAPS
+	if (
+		words.some((w) => w.length > 1) &&
+		words.every((w) => w === w.toUpperCase()) &&
+		!words.every((w) => TITLE_ACRONYMS.has(w))
+	) {
+		return true;
+	}
+
+	return words.some((word, i) => {
+		// Acronyms and mixed-case names (vK8s, mTLS) are kept as written
+		if (word === word.toUpperCase() || /\p{Lu}/u.test(word.slice(1))) {
+			return false;
+		}
+
+		const capitalized = /^\p{Lu}/u.test(word);
+		if (i === 0) return !capitalized;
+		return style === "title"
+			? !capitalized && !TITLE_MINOR_WORDS.has(word)
+			: capitalized;
+	});
+}
+
 /**
  * Check if 3-tier descriptions are properly differentiated
  */
 function hasProperDifferentiation(entry: SpecIndexEntry): boolean {
+	const short = entry["x-f5xc-description-short"];
+	const medium = entry["x-f5xc-description-medium"];
+	const long = entry.description ?? "";
+
+	// A missing tier can't be differentiated from the others
+	if (!short || !medium) return false;
+
 	// All three tiers should be different
-	if (entry.description_short === entry.description) return false;
-	if (entry.description_short === entry.description_medium) return false;
+	if (short === long) return false;
+	if (short === medium) return false;
 
 	// Short should be shorter than medium
-	if (entry.description_short.length >= entry.description_medium.length)
-		return false;
+	if (short.length >= medium.length) return false;
 
 	// Medium should be shorter than long
-	if (entry.description_medium.length >= entry.description.length) return false;
+	if (medium.length >= long.length) return false;
 
 	return true;
 }
 
+// Fields that must be strings when present; missing values are reported by
+// the checks that read them
+const STRING_FIELDS = [
+	"domain",
+	"title",
+	"description",
+	"x-f5xc-description-short",
+	"x-f5xc-description-medium",
+] as const;
+
+/**
+ * Describe why an index entry can't be analyzed, or undefined if it can
+ */
+function describeMalformedEntry(entry: unknown): string | undefined {
+	if (!entry || typeof entry !== "object") {
+		return "entry is not an object";
+	}
+
+	const record = entry as Record<string, unknown>;
+	for (const field of STRING_FIELDS) {
+		const value = record[field];
+		if (value !== undefined && typeof value !== "string") {
+			return `${field} is ${typeof value}, expected string`;
+		}
+	}
+
+	return undefined;
+}
+
+/**
+ * Format an entry's path/schema counts, marking counts that aren't numbers
+ */
+function formatCounts(entry: SpecIndexEntry): string {
+	const format = (count: unknown) =>
+		typeof count === "number" ? String(count) : "?";
+	return `${format(entry?.path_count)}/${format(entry?.schema_count)}`;
+}
+
+/**
+ * Order entries by domain, breaking ties on counts and then content so
+ * duplicate domains always come out in the same order
+ */
+function compareEntries(a: SpecIndexEntry, b: SpecIndexEntry): number {
+	const text = (value: unknown) => (typeof value === "string" ? value : "");
+	return (
+		compareNatural(text(a?.domain), text(b?.domain)) ||
+		compareNatural(formatCounts(a), formatCounts(b)) ||
+		compareNatural(text(a?.description), text(b?.description)) ||
+		compareNatural(JSON.stringify(a) ?? "", JSON.stringify(b) ?? "")
+	);
+}
+
+/**
+ * Compare strings with embedded numbers in numeric order
+ * (so specifications[2] sorts before specifications[10])
+ */
+function compareNatural(a: string, b: string): number {
+	return a.localeCompare(b, "en", { numeric: true });
+}
+
+/**
+ * Normalize a title for cross-domain comparison
+ */
+function normalizeTitle(title: string): string {
+	return title.normalize("NFC").trim().toLowerCase();
+}
+
+/**
+ * Group domains by normalized title, keeping only titles used more than once
+ */
+function findDuplicateTitles(index: SpecIndex): Map<string, string[]> {
+	const byTitle = new Map<string, string[]>();
+
+	for (const entry of index.specifications) {
+		if (describeMalformedEntry(entry)) continue;
+		if (!entry.domain?.trim() || !entry.title?.trim()) continue;
+
+		const key = normalizeTitle(entry.title);
+		const domains = byTitle.get(key) ?? [];
+		domains.push(entry.domain);
+		byTitle.set(key, domains);
+	}
+
+	for (const [title, domains] of byTitle) {
+		if (domains.length < 2) byTitle.delete(title);
+	}
+
+	return byTitle;
+}
+
+/**
+ * Group domains by description, keeping only descriptions reused by more
+ * than MAX_DESCRIPTION_REUSE domains
+ */
+function findReusedDescriptions(index: SpecIndex): Map<string, string[]> {
+	const byDescription = new Map<string, string[]>();
+	if (MAX_DESCRIPTION_REUSE <= 0) return byDescription;
+
+	for (const entry of index.specifications) {
+		if (describeMalformedEntry(entry)) continue;
+		if (!entry.domain?.trim() || !entry.description?.trim()) continue;
+
+		const key = entry.description.normalize("NFC").trim();
+		const domains = byDescription.get(key) ?? [];
+		domains.push(entry.domain);
+		byDescription.set(key, domains);
+	}
+
+	for (const [description, domains] of byDescription) {
+		if (domains.length <= MAX_DESCRIPTION_REUSE) {
+			byDescription.delete(description);
+		}
+	}
+
+	return byDescription;
+}
+
+/**
+ * Find domains listed more than once with differing path/schema counts,
+ * which points at a bad merge of index shards upstream
+ */
+function findInconsistentDuplicates(index: SpecIndex): Map<string, string> {
+	const byDomain = new Map<string, SpecIndexEntry[]>();
+	for (const entry of index.specifications) {
+		if (describeMalformedEntry(entry) || !entry.domain?.trim()) continue;
+		const entries = byDomain.get(entry.domain) ?? [];
+		entries.push(entry);
+		byDomain.set(entry.domain, entries);
+	}
+
+	const conflicts = new Map<string, string>();
+	for (const [domain, entries] of byDomain) {
+		// Missing counts can't be shown to match, so they conflict too
+		const counts = new Set(entries.map(formatCounts));
+		if (counts.size < 2) continue;
+
+		const sortedCounts = Array.from(counts).sort(compareNatural);
+
+		conflicts.set(
+			domain,
+			`Listed ${entries.length} times with conflicting path/schema counts (${sortedCounts.join(", ")})`,
+		);
+	}
+
+	return conflicts;
+}
+
 /**
  * Analyze domain-level description quality
  */
-function analyzeDomainDescriptions(index: SpecIndex): DomainGap[] {
+export function analyzeDomainDescriptions(index: SpecIndex): DomainGap[] {
 	const gaps: DomainGap[] = [];
+	const duplicateTitles = findDuplicateTitles(index);
+	const reusedDescriptions = findReusedDescriptions(index);
+	const conflictingCounts = findInconsistentDuplicates(index);
+	const reportedConflicts = new Set<string>();
+
+	// Analyze a sorted copy so upstream reordering can't change which
+	// duplicate carries a finding; positions refer to the original index
+	const ordered = Array.from(index.specifications.entries()).sort(
+		([, a], [, b]) => compareEntries(a, b),
+	);
+
+	for (const [position, entry] of ordered) {
+		// A single malformed entry is reported by position instead of
+		// aborting the whole analysis
+		const problem = describeMalformedEntry(entry);
+		if (problem) {
+			// Keep a usable domain name so the finding lands under its heading
+			const domain: unknown = entry?.domain;
+			const named = typeof domain === "string" && domain.trim() !== "";
+			gaps.push({
+				domain: named ? domain : `specifications[${position}]`,
+				issues: [
+					named
+						? `Unparseable entry at specifications[${position}]: ${problem}`
+						: `Unparseable entry: ${problem}`,
+				],
+				info: [],
+				severity: "high",
+			});
+			continue;
+		}
+
+		// An entry without a domain (whitespace-only counts as missing) is
+		// unaddressable; report its position and skip the per-domain checks
+		// that would be attributed to a blank heading
+		if (!entry.domain?.trim()) {
+			gaps.push({
+				domain: `specifications[${position}]`,
+				issues: [
+					isWhitespaceOnly(entry.domain)
+						? `Empty domain name (whitespace-only: ${JSON.stringify(entry.domain)})`
+						: "Empty domain name",
+				],
+				info: [],
+				severity: "high",
+			});
+			continue;
+		}
 
-	for (const entry of index.specifications) {
 		const issues: string[] = [];
+		const info: string[] = [];
+
+		// Check for missing title (whitespace-only counts as missing)
+		if (!entry.title) {
+			issues.push("Title is missing");
+		} else if (isWhitespaceOnly(entry.title)) {
+			issues.push(`Title is missing (whitespace-only: ${JSON.stringify(entry.title)})`);
+		}
 
 		// Check for generic description
-		if (isGenericDescription(entry.description)) {
-			issues.push("Long description is generic/placeholder");
+		const isGeneric = isGenericDescription(entry.description);
+		if (isGeneric) {
+			issues.push(
+				isWhitespaceOnly(entry.description)
+					? `Long description is generic/placeholder (whitespace-only: ${JSON.stringify(entry.description)})`
+					: "Long description is generic/placeholder",
+			);
+		}
+
+		// Check for description reused verbatim across many domains
+		const reusedBy = entry.description
+			? reusedDescriptions
+					.get(entry.description.normalize("NFC").trim())
+					?.filter((d) => d !== entry.domain)
+					.sort(compareNatural)
+			: undefined;
+		if (reusedBy?.length) {
+			const sample = reusedBy.slice(0, 5).join(", ");
+			const more = reusedBy.length > 5 ? `, +${reusedBy.length - 5} more` : "";
+			info.push(`Long description is reused verbatim (shared with ${reusedBy.length} other domains: ${sample}${more})`);
 		}
 
 		// Check for proper 3-tier differentiation
@@ -149,33 +530,169 @@ function analyzeDomainDescriptions(index: SpecIndex): DomainGap[] {
 			issues.push("3-tier descriptions not properly differentiated");
 		}
 
-		// Check character limits
-		if (entry.description_short.length > 60) {
-			issues.push(`Short description exceeds 60 chars (${entry.description_short.length})`);
+		// Check for title that adds nothing over the domain name
+		if (entry.title && isTitleDomainName(entry.title, entry.domain)) {
+			info.push("Title only repeats the domain name");
+		} else if (entry.title && hasInconsistentTitleCase(entry.title, TITLE_CASE_STYLE)) {
+			// Acronyms like DNS/WAF are allowed to stay upper case
+			const style = TITLE_CASE_STYLE === "title" ? "Title Case" : "sentence case";
+			info.push(`Title is not in ${style} (${JSON.stringify(entry.title)})`);
+		}
+
+		// Check for very short title
+		const titleLength = Array.from(entry.title?.trim() ?? "").length;
+		if (titleLength > 0 && titleLength < MIN_TITLE_LENGTH) {
+			info.push(`Title is too short (${titleLength} chars, minimum ${MIN_TITLE_LENGTH})`);
+		}
+
+		// Check for title shared with other domains
+		const sharedWith = entry.title
+			? duplicateTitles
+					.get(normalizeTitle(entry.title))
+					?.filter((d) => d !== entry.domain)
+					.sort(compareNatural)
+			: undefined;
+		if (sharedWith?.length) {
+			info.push(`Title is shared with other domains (${sharedWith.join(", ")})`);
+		}
+
+		// Check for leading/trailing whitespace (quoted so it stays visible)
+		if (isUntrimmed(entry.title)) {
+			info.push(`Title has leading/trailing whitespace (${JSON.stringify(entry.title)})`);
+		}
+		if (isUntrimmed(entry.description)) {
+			info.push(`Long description has leading/trailing whitespace (${JSON.stringify(entry.description)})`);
+		}
+
+		// Check for missing category (the generator files these under "Other");
+		// opt-in, but a real gap rather than info once enabled
+		if (REQUIRE_CATEGORY_COVERAGE && !entry["x-f5xc-category"]) {
+			issues.push("Missing x-f5xc-category");
+		}
+
+		// Check for non-NFC text, which defeats exact-match comparisons
+		if (isNotNfc(entry.title)) {
+			info.push("Title is not Unicode NFC-normalized");
 		}
-		if (entry.description_medium.length > 150) {
-			issues.push(`Medium description exceeds 150 chars (${entry.description_medium.length})`);
+		if (isNotNfc(entry.description)) {
+			info.push("Long description is not Unicode NFC-normalized");
 		}
 
-		if (issues.length > 0) {
+		// Check character limits (missing tiers are caught by differentiation)
+		const shortLength = entry["x-f5xc-description-short"]?.length ?? 0;
+		if (shortLength > 60) {
+			issues.push(`Short description exceeds 60 chars (${shortLength})`);
+		}
+		const mediumLength = entry["x-f5xc-description-medium"]?.length ?? 0;
+		if (mediumLength > 150) {
+			issues.push(`Medium description exceeds 150 chars (${mediumLength})`);
+		}
+
+		// Report conflicting counts once, on the domain's first entry in
+		// sorted order (lowest counts); a bad shard merge is high severity
+		// on its own
+		const conflict = conflictingCounts.get(entry.domain);
+		let hasConflict = false;
+		if (conflict && !reportedConflicts.has(entry.domain)) {
+			issues.push(conflict);
+			reportedConflicts.add(entry.domain);
+			hasConflict = true;
+		}
+
+		// Severity comes from real gaps only; info findings never raise it
+		if (issues.length > 0 || info.length > 0) {
 			const severity =
-				issues.length >= 2
-					? "high"
-					: issues.some((i) => i.includes("generic"))
-						? "medium"
-						: "low";
+				issues.length === 0
+					? "info"
+					: issues.length >= 2 || hasConflict
+						? "high"
+						: isGeneric
+							? "medium"
+							: "low";
 
 			gaps.push({
 				domain: entry.domain,
 				issues,
+				info,
 				severity,
 			});
 		}
 	}
 
+	// Entries are already in domain order; this places gaps reported by
+	// position (specifications[N]) among the domain names
+	gaps.sort((a, b) => compareNatural(a.domain, b.domain));
+
 	return gaps;
 }
 
+/**
+ * Compute per-category coverage (share of domains without gaps)
+ */
+export function computeCategoryCoverage(
+	index: SpecIndex,
+	domainGaps: DomainGap[],
+): CategoryCoverage[] {
+	const gapsByDomain = new Map<string, DomainGap[]>();
+	for (const gap of domainGaps) {
+		gapsByDomain.set(gap.domain, [...(gapsByDomain.get(gap.domain) ?? []), gap]);
+	}
+	const byCategory = new Map<string, CategoryCoverage>();
+	const seen = new Set<string>();
+
+	for (const entry of index.specifications) {
+		// Malformed and nameless entries are reported by position instead
+		if (describeMalformedEntry(entry) || !entry.domain?.trim()) continue;
+		// Domains listed more than once count once
+		if (seen.has(entry.domain)) continue;
+		seen.add(entry.domain);
+
+		const category = entry["x-f5xc-category"] || UNCATEGORIZED;
+		let bucket = byCategory.get(category);
+		if (!bucket) {
+			bucket = {
+				category,
+				domains: 0,
+				domainsWithGaps: 0,
+				issueCount: 0,
+				coverage: 0,
+			};
+			byCategory.set(category, bucket);
+		}
+
+		bucket.domains++;
+		const issueCount = (gapsByDomain.get(entry.domain) ?? []).reduce(
+			(sum, gap) => sum + gap.issues.length,
+			0,
+		);
+		if (issueCount > 0) {
+			bucket.domainsWithGaps++;
+			bucket.issueCount += issueCount;
+		}
+	}
+
+	for (const bucket of byCategory.values()) {
+		bucket.coverage =
+			((bucket.domains - bucket.domainsWithGaps) / bucket.domains) * 100;
+	}
+
+	// Lowest coverage first so lagging areas stand out; uncategorized last
+	return Array.from(byCategory.values()).sort((a, b) => {
+		if (a.category === UNCATEGORIZED) return 1;
+		if (b.category === UNCATEGORIZED) return -1;
+		return a.coverage - b.coverage || a.category.localeCompare(b.category);
+	});
+}
+
+/**
+ * Count domains that fell into the uncategorized bucket
+ */
+function countUncategorized(categoryCoverage: CategoryCoverage[]): number {
+	return (
+		categoryCoverage.find((b) => b.category === UNCATEGORIZED)?.domains ?? 0
+	);
+}
+
 /**
  * Analyze operation-level description quality
  */
@@ -253,6 +770,7 @@ function analyzeOperationDescriptions(specsDir: string): OperationGap[] {
 function generateReport(
 	domainGaps: DomainGap[],
 	operationGaps: OperationGap[],
+	categoryCoverage: CategoryCoverage[],
 	specVersion: string,
 ): string {
 	const lines: string[] = [];
@@ -267,6 +785,7 @@ function generateReport(
 	const highSeverity = domainGaps.filter((g) => g.severity === "high").length;
 	const mediumSeverity = domainGaps.filter((g) => g.severity === "medium").length;
 	const lowSeverity = domainGaps.filter((g) => g.severity === "low").length;
+	const infoOnly = domainGaps.filter((g) => g.severity === "info").length;
 
 	lines.push("## Summary");
 	lines.push("");
@@ -274,19 +793,38 @@ function generateReport(
 	lines.push(`  - High severity: ${highSeverity}`);
 	lines.push(`  - Medium severity: ${mediumSeverity}`);
 	lines.push(`  - Low severity: ${lowSeverity}`);
+	lines.push(`  - Info only: ${infoOnly}`);
 	lines.push(`- **Operation-level gaps**: ${operationGaps.length}`);
+	lines.push(`- **Uncategorized domains**: ${countUncategorized(categoryCoverage)}`);
 	lines.push("");
 
+	// Coverage by category
+	if (categoryCoverage.length > 0) {
+		lines.push("## Coverage by Category");
+		lines.push("");
+		lines.push("| Category | Domains | Coverage | Issues |");
+		lines.push("|----------|---------|----------|--------|");
+
+		for (const bucket of categoryCoverage) {
+			lines.push(`| ${bucket.category} | ${bucket.domains} | ${bucket.coverage.toFixed(1)}% | ${bucket.issueCount} |`);
+		}
+		lines.push("");
+	}
+
 	// Domain gaps by severity
 	if (domainGaps.length > 0) {
 		lines.push("## Domain-Level Gaps");
 		lines.push("");
 
-		for (const severity of ["high", "medium", "low"] as const) {
+		for (const severity of ["high", "medium", "low", "info"] as const) {
 			const sevGaps = domainGaps.filter((g) => g.severity === severity);
 			if (sevGaps.length === 0) continue;
 
-			lines.push(`### ${severity.charAt(0).toUpperCase() + severity.slice(1)} Severity`);
+			lines.push(
+				severity === "info"
+					? "### Info Only"
+					: `### ${severity.charAt(0).toUpperCase() + severity.slice(1)} Severity`,
+			);
 			lines.push("");
 
 			for (const gap of sevGaps) {
@@ -295,6 +833,9 @@ function generateReport(
 				for (const issue of gap.issues) {
 					lines.push(`- ${issue}`);
 				}
+				for (const note of gap.info) {
+					lines.push(`- *(info)* ${note}`);
+				}
 				lines.push("");
 			}
 		}
@@ -348,8 +889,8 @@ function generateReport(
 	lines.push("1. **High severity domains** should be prioritized for description improvement");
 	lines.push("2. Add `x-f5xc-operation-metadata.purpose` to operations missing it");
 	lines.push("3. Ensure 3-tier descriptions are properly differentiated:");
-	lines.push("   - `description_short`: ~60 characters, action-oriented");
-	lines.push("   - `description_medium`: ~150 characters, adds context");
+	lines.push("   - `x-f5xc-description-short`: ~60 characters, action-oriented");
+	lines.push("   - `x-f5xc-description-medium`: ~150 characters, adds context");
 	lines.push("   - `description`: ~500 characters, comprehensive details");
 	lines.push("4. Replace auto-generated summaries with meaningful descriptions");
 	lines.push("");
@@ -376,8 +917,7 @@ async function main(): Promise<void> {
 	}
 
 	// Load index
-	const indexData = fs.readFileSync(indexPath, "utf-8");
-	const index: SpecIndex = JSON.parse(indexData);
+	const index = loadSpecIndex<SpecIndex>(indexPath);
 	console.log(`✓ Loaded spec index v${index.version}`);
 
 	// Analyze domain descriptions
@@ -390,8 +930,16 @@ async function main(): Promise<void> {
 	const operationGaps = analyzeOperationDescriptions(domainsDir);
 	console.log(`  Found ${operationGaps.length} operations with gaps`);
 
+	// Compute coverage per category
+	const categoryCoverage = computeCategoryCoverage(index, domainGaps);
+
 	// Generate report
-	const report = generateReport(domainGaps, operationGaps, index.version);
+	const report = generateReport(
+		domainGaps,
+		operationGaps,
+		categoryCoverage,
+		index.version,
+	);
 
 	// Ensure output directory exists
 	const outputDir = path.dirname(outputPath);
@@ -407,7 +955,20 @@ async function main(): Promise<void> {
 	console.log("");
 	console.log("📊 Gap Analysis Summary:");
 	console.log(`   Domain-level gaps: ${domainGaps.length}`);
+	for (const severity of ["high", "medium", "low", "info"] as const) {
+		const count = domainGaps.filter((g) => g.severity === severity).length;
+		console.log(`     ${severity}: ${count}`);
+	}
 	console.log(`   Operation-level gaps: ${operationGaps.length}`);
+	console.log(`   Uncategorized domains: ${countUncategorized(categoryCoverage)}`);
+
+	console.log("");
+	console.log("📂 Coverage by Category:");
+	for (const bucket of categoryCoverage) {
+		console.log(
+			`   ${bucket.category}: ${bucket.coverage.toFixed(1)}% (${bucket.issueCount} issues)`,
+		);
+	}
 
 	if (domainGaps.filter((g) => g.severity === "high").length > 0) {
 		console.log("");
@@ -418,7 +979,10 @@ async function main(): Promise<void> {
 	console.log("✅ Gap analysis complete!");
 }
 
-main().catch((err) => {
-	console.error("❌ Analysis failed:", err);
-	process.exit(1);
-});
+// Only run when executed directly so tests can import the analysis functions
+if (path.resolve(process.argv[1] ?? "") === fileURLToPath(import.meta.url)) {
+	main().catch((err) => {
+		console.error("❌ Analysis failed:", err);
+		process.exit(1);
+	});
+}
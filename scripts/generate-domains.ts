@@ -10,6 +10,7 @@ import * as fs from "fs";
 import * as path from "path";
 import * as yaml from "yaml";
 import { execSync } from "child_process";
+import { loadSpecIndex } from "./spec-index.js";
 
 // Types matching the upstream spec structure
 interface SpecPrimaryResource {
@@ -248,8 +249,8 @@ async function main(): Promise<void> {
 		process.exit(1);
 	}
 
-	const indexData = fs.readFileSync(indexPath, "utf-8");
-	const specIndex: SpecIndex = JSON.parse(indexData);
+	// Refuses to overwrite the registry with an empty one
+	const specIndex = loadSpecIndex<SpecIndex>(indexPath);
 	console.log(
 		`✓ Loaded spec index v${specIndex.version} with ${specIndex.specifications.length} domains`,
 	);
@@ -0,0 +1,49 @@
+/**
+ * Spec Index Loader
+ * Shared by the scripts that read .specs/index.json
+ *
+ * An index that is empty, unparseable, or has no specifications exits with
+ * EXIT_EMPTY_INPUT so callers can tell unusable input apart from other
+ * failures (exit 1).
+ */
+
+import * as fs from "fs";
+
+// Exit code for empty or unusable input
+export const EXIT_EMPTY_INPUT = 2;
+
+/**
+ * Read and parse a spec index, exiting with EXIT_EMPTY_INPUT if it is unusable
+ */
+export function loadSpecIndex<T extends { specifications: unknown[] }>(
+	indexPath: string,
+): T {
+	const indexData = fs.readFileSync(indexPath, "utf-8");
+	if (indexData.trim() === "") {
+		console.error(`❌ Spec index is empty: ${indexPath}`);
+		console.error("   Re-run 'make download-specs' to fetch a fresh copy.");
+		process.exit(EXIT_EMPTY_INPUT);
+	}
+
+	let index: unknown;
+	try {
+		index = JSON.parse(indexData);
+	} catch (err) {
+		console.error(`❌ Spec index is not valid JSON: ${indexPath}`);
+		console.error(`   ${err instanceof Error ? err.message : String(err)}`);
+		process.exit(EXIT_EMPTY_INPUT);
+	}
+
+	// A bare `null` or array parses fine but has nothing to read
+	const specifications =
+		typeof index === "object" && index !== null
+			? (index as { specifications?: unknown }).specifications
+			: undefined;
+	if (!Array.isArray(specifications) || specifications.length === 0) {
+		console.error(`❌ Spec index has no specifications: ${indexPath}`);
+		console.error("   The file may be truncated or malformed.");
+		process.exit(EXIT_EMPTY_INPUT);
+	}
+
+	return index as T;
+}
@@ -0,0 +1,441 @@
+/**
+ * Description Gap Analysis Tests
+ * Tests for the domain-level checks in scripts/analyze-description-gaps.ts
+ */
+
+import { describe, it, expect } from "vitest";
+import {
+	analyzeDomainDescriptions,
+	computeCategoryCoverage,
+} from "../../scripts/analyze-description-gaps.js";
+import type {
+	DomainGap,
+	SpecIndex,
+	SpecIndexEntry,
+} from "../../scripts/analyze-description-gaps.js";
+
+function entry(domain: string, overrides: Partial<SpecIndexEntry> = {}): SpecIndexEntry {
+	return {
+		domain,
+		title: `${domain.charAt(0).toUpperCase()}${domain.slice(1)} Service`,
+		description: `Configure ${domain} resources, policies and related settings across every namespace in the tenant.`,
+		"x-f5xc-description-short": `Configure ${domain}`,
+		"x-f5xc-description-medium": `Configure ${domain} resources and policies.`,
+		path_count: 10,
+		schema_count: 20,
+		...overrides,
+	};
+}
+
+function index(specifications: SpecIndexEntry[]): SpecIndex {
+	return { version: "1.0.0", specifications };
+}
+
+interface CheckCase {
+	description: string;
+	specifications: SpecIndexEntry[];
+	expected: DomainGap[];
+}
+
+/**
+ * One case per check: a crafted index and the exact gaps it produces
+ */
+const checkMatrix: CheckCase[] = [
+	{
+		description: "clean entry produces no gaps",
+		specifications: [entry("alpha")],
+		expected: [],
+	},
+	{
+		description: "untrimmed title is info with the raw value quoted",
+		specifications: [entry("alpha", { title: " Alpha Service " })],
+		expected: [
+			{
+				domain: "alpha",
+				issues: [],
+				info: ['Title has leading/trailing whitespace (" Alpha Service ")'],
+				severity: "info",
+			},
+		],
+	},
+	{
+		description: "untrimmed description is info with the raw value quoted",
+		specifications: [
+			entry("alpha", {
+				description:
+					"Configure alpha resources, policies and related settings across every namespace. ",
+			}),
+		],
+		expected: [
+			{
+				domain: "alpha",
+				issues: [],
+				info: [
+					'Long description has leading/trailing whitespace ("Configure alpha resources, policies and related settings across every namespace. ")',
+				],
+				severity: "info",
+			},
+		],
+	},
+	{
+		description: "title that repeats the domain name is info",
+		specifications: [entry("load_balancer", { title: "Load Balancer" })],
+		expected: [
+			{
+				domain: "load_balancer",
+				issues: [],
+				info: ["Title only repeats the domain name"],
+				severity: "info",
+			},
+		],
+	},
+	{
+		description: "empty domain name is reported by position",
+		specifications: [entry("")],
+		expected: [
+			{
+				domain: "specifications[0]",
+				issues: ["Empty domain name"],
+				info: [],
+				severity: "high",
+			},
+		],
+	},
+	{
+		description: "whitespace-only domain name counts as empty",
+		specifications: [entry("  ")],
+		expected: [
+			{
+				domain: "specifications[0]",
+				issues: ['Empty domain name (whitespace-only: "  ")'],
+				info: [],
+				severity: "high",
+			},
+		],
+	},
+	{
+		description: "title shared across domains is info on each",
+		specifications: [
+			entry("alpha", { title: "Shared Service" }),
+			entry("beta", { title: "shared service " }),
+		],
+		expected: [
+			{
+				domain: "alpha",
+				issues: [],
+				info: ["Title is shared with other domains (beta)"],
+				severity: "info",
+			},
+			{
+				domain: "beta",
+				issues: [],
+				info: [
+					'Title is not in Title Case ("shared service ")',
+					"Title is shared with other domains (alpha)",
+					'Title has leading/trailing whitespace ("shared service ")',
+				],
+				severity: "info",
+			},
+		],
+	},
+	{
+		description: "short titles are not flagged by default",
+		specifications: [entry("alpha", { title: "Al" })],
+		expected: [],
+	},
+	{
+		description: "wrong-typed field marks the entry unparseable",
+		specifications: [entry("alpha", { description: null as unknown as string })],
+		expected: [
+			{
+				domain: "alpha",
+				issues: [
+					"Unparseable entry at specifications[0]: description is object, expected string",
+				],
+				info: [],
+				severity: "high",
+			},
+		],
+	},
+	{
+		description: "non-object entry is reported by position",
+		specifications: [null as unknown as SpecIndexEntry],
+		expected: [
+			{
+				domain: "specifications[0]",
+				issues: ["Unparseable entry: entry is not an object"],
+				info: [],
+				severity: "high",
+			},
+		],
+	},
+	{
+		description: "whitespace-only title counts as missing",
+		specifications: [entry("alpha", { title: "   " })],
+		expected: [
+			{
+				domain: "alpha",
+				issues: ['Title is missing (whitespace-only: "   ")'],
+				info: [],
+				severity: "low",
+			},
+		],
+	},
+	{
+		description: "whitespace-only description is generic",
+		specifications: [entry("alpha", { description: "   " })],
+		expected: [
+			{
+				domain: "alpha",
+				issues: [
+					'Long description is generic/placeholder (whitespace-only: "   ")',
+					"3-tier descriptions not properly differentiated",
+				],
+				info: [],
+				severity: "high",
+			},
+		],
+	},
+	{
+		description: "generic description alone is medium",
+		specifications: [
+			entry("alpha", { description: "F5 Distributed Cloud Alpha API specifications" }),
+		],
+		expected: [
+			{
+				domain: "alpha",
+				issues: ["Long description is generic/placeholder"],
+				info: [],
+				severity: "medium",
+			},
+		],
+	},
+	{
+		description: "non-NFC title is info",
+		specifications: [entry("alpha", { title: "Cafe\u0301 Service" })],
+		expected: [
+			{
+				domain: "alpha",
+				issues: [],
+				info: ["Title is not Unicode NFC-normalized"],
+				severity: "info",
+			},
+		],
+	},
+	{
+		description: "missing category is not flagged by default",
+		specifications: [entry("alpha")],
+		expected: [],
+	},
+	{
+		description: "reused descriptions are not flagged by default",
+		specifications: [
+			entry("alpha", { description: "Shared long description used by several domains." }),
+			entry("beta", { description: "Shared long description used by several domains." }),
+			entry("gamma", { description: "Shared long description used by several domains." }),
+		],
+		expected: [],
+	},
+	{
+		description: "short description over 60 chars is an issue",
+		specifications: [
+			entry("alpha", {
+				"x-f5xc-description-short": "Configure alpha resources and policies for every tenant here.",
+				"x-f5xc-description-medium":
+					"Configure alpha resources and policies for every tenant and namespace in the account.",
+			}),
+		],
+		expected: [
+			{
+				domain: "alpha",
+				issues: ["Short description exceeds 60 chars (61)"],
+				info: [],
+				severity: "low",
+			},
+		],
+	},
+];
+
+describe("domain checks", () => {
+	for (const testCase of checkMatrix) {
+		it(testCase.description, () => {
+			expect(analyzeDomainDescriptions(index(testCase.specifications))).toEqual(
+				testCase.expected,
+			);
+		});
+	}
+});
+
+describe("computeCategoryCoverage", () => {
+	it("counts domains with issues per category, ignoring info-only gaps", () => {
+		const specIndex = index([
+			entry("alpha", { "x-f5xc-category": "Networking" }),
+			entry("beta", {
+				"x-f5xc-category": "Networking",
+				description: "F5 Distributed Cloud Beta API specifications",
+			}),
+			entry("delta", { "x-f5xc-category": "Networking", title: "delta service" }),
+			entry("epsilon", { "x-f5xc-category": "Networking" }),
+			entry("gamma"),
+		]);
+
+		expect(computeCategoryCoverage(specIndex, analyzeDomainDescriptions(specIndex))).toEqual([
+			{
+				category: "Networking",
+				domains: 4,
+				domainsWithGaps: 1,
+				issueCount: 1,
+				coverage: 75,
+			},
+			{
+				category: "(uncategorized)",
+				domains: 1,
+				domainsWithGaps: 0,
+				issueCount: 0,
+				coverage: 100,
+			},
+		]);
+	});
+});
+
+describe("analyzeDomainDescriptions", () => {
+	it("produces the same gaps regardless of index order", () => {
+		const shared = "Shared Title";
+		const specifications = [
+			entry("zeta", { title: shared }),
+			entry("alpha", { title: shared }),
+			entry("mid", { title: shared }),
+			entry("beta", { description: "Manage beta resources." }),
+			entry("gamma", { path_count: 11 }),
+			entry("gamma", { path_count: 12 }),
+		];
+		const shuffled = [
+			specifications[4]!,
+			specifications[2]!,
+			specifications[5]!,
+			specifications[0]!,
+			specifications[3]!,
+			specifications[1]!,
+		];
+
+		expect(analyzeDomainDescriptions(index(shuffled))).toEqual(
+			analyzeDomainDescriptions(index(specifications)),
+		);
+	});
+
+	it("attaches findings to the same duplicate regardless of index order", () => {
+		const generic = entry("gamma", {
+			path_count: 11,
+			description: "F5 Distributed Cloud Gamma API specifications",
+		});
+		const clean = entry("gamma", { path_count: 12 });
+
+		expect(analyzeDomainDescriptions(index([clean, generic]))).toEqual(
+			analyzeDomainDescriptions(index([generic, clean])),
+		);
+	});
+
+	it("orders malformed entry positions numerically", () => {
+		const specifications: SpecIndexEntry[] = Array.from({ length: 11 }, (_, i) =>
+			entry(`domain${i}`),
+		);
+		specifications[2] = { title: 1 } as unknown as SpecIndexEntry;
+		specifications[10] = { title: 1 } as unknown as SpecIndexEntry;
+
+		const domains = analyzeDomainDescriptions(index(specifications)).map((g) => g.domain);
+
+		expect(domains.indexOf("specifications[2]")).toBeLessThan(
+			domains.indexOf("specifications[10]"),
+		);
+	});
+
+	it("reports conflicting counts on the domain's existing gap", () => {
+		const gaps = analyzeDomainDescriptions(
+			index([
+				entry("alpha"),
+				entry("gamma", { path_count: 11 }),
+				entry("gamma", { path_count: 12 }),
+			]),
+		);
+
+		expect(gaps).toEqual([
+			{
+				domain: "gamma",
+				issues: ["Listed 2 times with conflicting path/schema counts (11/20, 12/20)"],
+				info: [],
+				severity: "high",
+			},
+		]);
+	});
+
+	it("keeps acronyms next to punctuation out of the Title Case check", () => {
+		const gaps = analyzeDomainDescriptions(
+			index([
+				entry("dns", { title: "DNS (WAF)" }),
+				entry("waf", { title: "API/WAF" }),
+				entry("lower", { title: "lower case title" }),
+			]),
+		);
+
+		expect(gaps).toEqual([
+			{
+				domain: "lower",
+				issues: [],
+				info: ['Title is not in Title Case ("lower case title")'],
+				severity: "info",
+			},
+		]);
+	});
+
+	it("analyzes entries missing optional description tiers and counts", () => {
+		const partial = entry("alpha");
+		delete partial["x-f5xc-description-medium"];
+		delete (partial as Partial<SpecIndexEntry>).path_count;
+
+		expect(analyzeDomainDescriptions(index([partial]))).toEqual([
+			{
+				domain: "alpha",
+				issues: ["3-tier descriptions not properly differentiated"],
+				info: [],
+				severity: "low",
+			},
+		]);
+	});
+
+	it("reports malformed entries under their domain name when it is usable", () => {
+		const gaps = analyzeDomainDescriptions(
+			index([
+				entry("alpha", { title: 42 as unknown as string }),
+				{ domain: 7 } as unknown as SpecIndexEntry,
+			]),
+		);
+
+		expect(gaps.map((g) => [g.domain, g.issues])).toEqual([
+			["alpha", ["Unparseable entry at specifications[0]: title is number, expected string"]],
+			["specifications[1]", ["Unparseable entry: domain is number, expected string"]],
+		]);
+	});
+
+	it("reports conflicting counts on the entry with the lowest counts", () => {
+		const low = entry("gamma", { path_count: 11 });
+		const high = entry("gamma", {
+			path_count: 12,
+			description: "F5 Distributed Cloud Gamma API specifications",
+		});
+
+		expect(analyzeDomainDescriptions(index([high, low]))).toEqual([
+			{
+				domain: "gamma",
+				issues: ["Listed 2 times with conflicting path/schema counts (11/20, 12/20)"],
+				info: [],
+				severity: "high",
+			},
+			{
+				domain: "gamma",
+				issues: ["Long description is generic/placeholder"],
+				info: [],
+				severity: "medium",
+			},
+		]);
+	});
+});